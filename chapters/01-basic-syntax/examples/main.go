@@ -0,0 +1,18 @@
+// Go版本的程序员示例
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/studyzy/Gopher-Learning-Rust/programmer"
+)
+
+func main() {
+	gopher, err := programmer.NewProgrammer("Alice", "Go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(gopher.Introduce())
+	fmt.Println(gopher)
+}
@@ -0,0 +1,39 @@
+package programmer
+
+import "github.com/studyzy/Gopher-Learning-Rust/pubsub"
+
+// ProgrammerCreated 在 NewProgrammer 成功创建一个 Programmer 时发布。
+type ProgrammerCreated struct {
+	Name     string
+	Language string
+}
+
+// LanguageChanged 在 Programmer.SetLanguage 改变主语言时发布。
+type LanguageChanged struct {
+	Name        string
+	OldLanguage string
+	NewLanguage string
+}
+
+// defaultPublisher 是本包所有 Programmer 生命周期事件的默认事件总线。
+var defaultPublisher = pubsub.NewPublisher(0)
+
+// Subscribe 订阅默认事件总线上的全部事件。
+func Subscribe() chan interface{} {
+	return defaultPublisher.Subscribe()
+}
+
+// SubscribeTopic 按 topic 订阅默认事件总线，topic 为 nil 时等价于 Subscribe。
+func SubscribeTopic(topic func(v interface{}) bool) chan interface{} {
+	return defaultPublisher.SubscribeTopic(topic)
+}
+
+// Evict 取消订阅默认事件总线上的 sub。
+func Evict(sub chan interface{}) {
+	defaultPublisher.Evict(sub)
+}
+
+// publish 向默认事件总线发布一个事件。
+func publish(event interface{}) {
+	defaultPublisher.Publish(event)
+}
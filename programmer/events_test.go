@@ -0,0 +1,92 @@
+package programmer
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewProgrammerPublishesCreated(t *testing.T) {
+	sub := SubscribeTopic(func(v interface{}) bool {
+		_, ok := v.(ProgrammerCreated)
+		return ok
+	})
+	defer Evict(sub)
+
+	if _, err := NewProgrammer("Alice", "Go"); err != nil {
+		t.Fatalf("NewProgrammer() returned unexpected error: %v", err)
+	}
+
+	select {
+	case v := <-sub:
+		evt, ok := v.(ProgrammerCreated)
+		if !ok || evt.Name != "Alice" || evt.Language != "Go" {
+			t.Errorf("got %#v, want ProgrammerCreated{Name: Alice, Language: Go}", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for ProgrammerCreated event")
+	}
+}
+
+func TestSetLanguagePublishesLanguageChanged(t *testing.T) {
+	sub := SubscribeTopic(func(v interface{}) bool {
+		_, ok := v.(LanguageChanged)
+		return ok
+	})
+	defer Evict(sub)
+
+	p, err := NewProgrammer("Bob", "Go")
+	if err != nil {
+		t.Fatalf("NewProgrammer() returned unexpected error: %v", err)
+	}
+	if err := p.SetLanguage("Rust"); err != nil {
+		t.Fatalf("SetLanguage() returned unexpected error: %v", err)
+	}
+
+	select {
+	case v := <-sub:
+		evt, ok := v.(LanguageChanged)
+		if !ok || evt.Name != "Bob" || evt.OldLanguage != "Go" || evt.NewLanguage != "Rust" {
+			t.Errorf("got %#v, want LanguageChanged{Name: Bob, OldLanguage: Go, NewLanguage: Rust}", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for LanguageChanged event")
+	}
+}
+
+func TestConcurrentCreateAndSubscribe(t *testing.T) {
+	const subscribers = 5
+	const creations = 20
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < subscribers; i++ {
+		sub := Subscribe()
+		wg.Add(1)
+		go func(sub chan interface{}) {
+			defer wg.Done()
+			defer Evict(sub)
+			for {
+				select {
+				case <-sub:
+				case <-stop:
+					return
+				}
+			}
+		}(sub)
+	}
+
+	var createWg sync.WaitGroup
+	for i := 0; i < creations; i++ {
+		createWg.Add(1)
+		go func(i int) {
+			defer createWg.Done()
+			if _, err := NewProgrammer("Racer", "Go"); err != nil {
+				t.Errorf("NewProgrammer() returned unexpected error: %v", err)
+			}
+		}(i)
+	}
+	createWg.Wait()
+	close(stop)
+	wg.Wait()
+}
@@ -0,0 +1,211 @@
+// Package programmer 定义 Programmer 类型及其格式化、校验逻辑，
+// 供上层的示例程序和 registry 等包复用。
+package programmer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrEmptyName 表示创建 Programmer 时 Name 字段为空。
+var ErrEmptyName = errors.New("programmer: name must not be empty")
+
+// ErrEmptyLanguage 表示创建 Programmer 时 Language 字段为空。
+var ErrEmptyLanguage = errors.New("programmer: language must not be empty")
+
+// Programmer 描述一名程序员的基本信息。name 在构造之后不再改变；
+// language/languages 可以通过 SetLanguage 并发修改，因此由 mu 保护，
+// 所有读写都必须经过 Name/Language/Languages/SetLanguage 等方法。
+type Programmer struct {
+	name string
+
+	mu        sync.RWMutex
+	language  string
+	languages []string
+
+	formatter Formatter
+}
+
+// Option 用于在构造 Programmer 时配置可选项。
+type Option func(*Programmer)
+
+// WithFormatter 设置 Introduce 使用的 Formatter。
+func WithFormatter(f Formatter) Option {
+	return func(p *Programmer) {
+		p.formatter = f
+	}
+}
+
+// WithLanguages 设置程序员掌握的全部语言，空字符串会被忽略；
+// Language 字段会被更新为第一个非空语言，以保持向后兼容。
+func WithLanguages(languages ...string) Option {
+	return func(p *Programmer) {
+		filtered := make([]string, 0, len(languages))
+		for _, lang := range languages {
+			if lang != "" {
+				filtered = append(filtered, lang)
+			}
+		}
+		if len(filtered) == 0 {
+			return
+		}
+		p.languages = filtered
+		p.language = filtered[0]
+	}
+}
+
+// NewProgrammer 创建一个 Programmer，默认使用 PlainFormatter，
+// 可以通过 opts 覆盖格式化方式或补充多门语言。
+// Name 和 Language 不能为空，否则返回错误。
+func NewProgrammer(name, language string, opts ...Option) (*Programmer, error) {
+	if name == "" {
+		return nil, ErrEmptyName
+	}
+	if language == "" {
+		return nil, ErrEmptyLanguage
+	}
+
+	p := &Programmer{
+		name:      name,
+		language:  language,
+		languages: []string{language},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.name == "" {
+		return nil, ErrEmptyName
+	}
+	if p.language == "" {
+		return nil, ErrEmptyLanguage
+	}
+	if p.formatter == nil {
+		p.formatter = PlainFormatter{}
+	}
+	publish(ProgrammerCreated{Name: p.name, Language: p.language})
+	return p, nil
+}
+
+// Name 返回程序员的姓名。姓名在构造之后不会改变，读取无需加锁。
+func (p *Programmer) Name() string {
+	return p.name
+}
+
+// Language 返回程序员当前的主语言。
+func (p *Programmer) Language() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.language
+}
+
+// Languages 返回程序员掌握的全部语言的一份拷贝。
+func (p *Programmer) Languages() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	languages := make([]string, len(p.languages))
+	copy(languages, p.languages)
+	return languages
+}
+
+// String 实现 fmt.Stringer，返回类似 "Alice (Go)" 的简短描述。
+func (p *Programmer) String() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return fmt.Sprintf("%s (%s)", p.name, p.language)
+}
+
+// Introduce 返回程序员的自我介绍，具体格式由配置的 Formatter 决定。
+// 它是 IntroduceContext(context.Background()) 的简单封装，用于向后兼容。
+func (p *Programmer) Introduce() string {
+	s, _ := p.IntroduceContext(context.Background())
+	return s
+}
+
+// IntroduceContext 与 Introduce 相同，但会在返回前检查 ctx 是否已取消或超时，
+// 以便调用方可以取消耗时较长的格式化或下游调用。
+func (p *Programmer) IntroduceContext(ctx context.Context) (string, error) {
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	default:
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.formatter.Format(p), nil
+}
+
+// SetLanguage 更新程序员的主语言，并向默认事件总线发布 LanguageChanged 事件。
+func (p *Programmer) SetLanguage(language string) error {
+	if language == "" {
+		return ErrEmptyLanguage
+	}
+
+	p.mu.Lock()
+	old := p.language
+	p.language = language
+	if len(p.languages) > 0 {
+		p.languages = append([]string{language}, p.languages[1:]...)
+	} else {
+		p.languages = []string{language}
+	}
+	p.mu.Unlock()
+
+	publish(LanguageChanged{Name: p.name, OldLanguage: old, NewLanguage: language})
+	return nil
+}
+
+// Formatter 把一个 Programmer 格式化为字符串，便于支持多种输出格式。
+// Format 的实现应只读取 Programmer 的字段；调用方（Introduce/IntroduceContext）
+// 已经持有读锁，实现内部不应再次加锁。
+type Formatter interface {
+	Format(p *Programmer) string
+}
+
+// PlainFormatter 生成与原始 Introduce() 一致的纯文本问候语。
+type PlainFormatter struct{}
+
+// Format 实现 Formatter。
+func (PlainFormatter) Format(p *Programmer) string {
+	return fmt.Sprintf("Hi, I'm %s and I love %s!", p.name, strings.Join(p.languages, ", "))
+}
+
+// JSONFormatter 把 Programmer 格式化为 JSON 字符串。
+type JSONFormatter struct{}
+
+// Format 实现 Formatter。
+func (JSONFormatter) Format(p *Programmer) string {
+	data, err := json.Marshal(struct {
+		Name      string   `json:"name"`
+		Languages []string `json:"languages"`
+	}{p.name, p.languages})
+	if err != nil {
+		return fmt.Sprintf(`{"error":%q}`, err.Error())
+	}
+	return string(data)
+}
+
+// YAMLFormatter 把 Programmer 格式化为简单的 YAML 字符串。
+type YAMLFormatter struct{}
+
+// Format 实现 Formatter。
+func (YAMLFormatter) Format(p *Programmer) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "name: %s\n", p.name)
+	b.WriteString("languages:")
+	for _, lang := range p.languages {
+		fmt.Fprintf(&b, "\n  - %s", lang)
+	}
+	return b.String()
+}
+
+// MarkdownFormatter 把 Programmer 格式化为 Markdown 字符串。
+type MarkdownFormatter struct{}
+
+// Format 实现 Formatter。
+func (MarkdownFormatter) Format(p *Programmer) string {
+	return fmt.Sprintf("**%s** loves *%s*", p.name, strings.Join(p.languages, ", "))
+}
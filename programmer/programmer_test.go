@@ -0,0 +1,150 @@
+package programmer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func mustNewProgrammer(t *testing.T, name, language string, opts ...Option) *Programmer {
+	t.Helper()
+	p, err := NewProgrammer(name, language, opts...)
+	if err != nil {
+		t.Fatalf("NewProgrammer(%q, %q) returned unexpected error: %v", name, language, err)
+	}
+	return p
+}
+
+func TestNewProgrammerValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		progName string
+		language string
+		wantErr  error
+	}{
+		{"empty name", "", "Go", ErrEmptyName},
+		{"empty language", "Alice", "", ErrEmptyLanguage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewProgrammer(tt.progName, tt.language)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewProgrammer(%q, %q) error = %v, want %v", tt.progName, tt.language, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProgrammerString(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go")
+	want := "Alice (Go)"
+	if got := p.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestIntroduceDefaultFormatter(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go")
+	want := "Hi, I'm Alice and I love Go!"
+	if got := p.Introduce(); got != want {
+		t.Errorf("Introduce() = %q, want %q", got, want)
+	}
+}
+
+func TestIntroduceFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter Formatter
+		want      string
+	}{
+		{"plain", PlainFormatter{}, "Hi, I'm Bob and I love Go, Rust!"},
+		{"json", JSONFormatter{}, `{"name":"Bob","languages":["Go","Rust"]}`},
+		{"yaml", YAMLFormatter{}, "name: Bob\nlanguages:\n  - Go\n  - Rust"},
+		{"markdown", MarkdownFormatter{}, "**Bob** loves *Go, Rust*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mustNewProgrammer(t, "Bob", "Go",
+				WithFormatter(tt.formatter),
+				WithLanguages("Go", "Rust"),
+			)
+			if got := p.Introduce(); got != tt.want {
+				t.Errorf("Introduce() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntroduceContextCancelled(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.IntroduceContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("IntroduceContext() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestIntroduceContextOK(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go")
+	got, err := p.IntroduceContext(context.Background())
+	if err != nil {
+		t.Fatalf("IntroduceContext() returned unexpected error: %v", err)
+	}
+	if want := "Hi, I'm Alice and I love Go!"; got != want {
+		t.Errorf("IntroduceContext() = %q, want %q", got, want)
+	}
+}
+
+func TestWithLanguagesIgnoresEmpty(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go", WithLanguages())
+	if !strings.Contains(p.Introduce(), "Go") {
+		t.Errorf("Introduce() = %q, want it to still mention Go", p.Introduce())
+	}
+}
+
+func TestWithLanguagesFiltersEmptyEntries(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go", WithLanguages("", "Java"))
+	if got := p.Language(); got != "Java" {
+		t.Errorf("Language() = %q, want %q", got, "Java")
+	}
+	for _, lang := range p.Languages() {
+		if lang == "" {
+			t.Errorf("Languages() = %v, want no empty entries", p.Languages())
+		}
+	}
+}
+
+func TestWithLanguagesAllEmptyKeepsOriginalLanguage(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go", WithLanguages("", ""))
+	if got := p.Language(); got != "Go" {
+		t.Errorf("Language() = %q, want %q", got, "Go")
+	}
+}
+
+func TestConcurrentSetLanguageAndIntroduce(t *testing.T) {
+	p := mustNewProgrammer(t, "Alice", "Go")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = p.SetLanguage("Rust")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = p.Introduce()
+			_ = p.String()
+			_ = p.Languages()
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,128 @@
+// Package pubsub 实现一个简单的发布/订阅总线：订阅者通过带缓冲的 channel
+// 接收事件，可选的 topic 过滤函数决定订阅者对哪些事件感兴趣。
+package pubsub
+
+import (
+	"sync"
+	"time"
+)
+
+// subscriberBufferSize 是每个订阅者 channel 的缓冲大小。
+const subscriberBufferSize = 8
+
+// defaultPublishTimeout 是 Publish 向单个订阅者发送事件的默认超时时间。
+const defaultPublishTimeout = 1 * time.Second
+
+type subscriber chan interface{}
+
+type topicFunc func(v interface{}) bool
+
+// entry 记录一个订阅者的过滤条件，以及投递给它、尚未完成的 Publish 调用数量，
+// 使 Evict/Close 能够在关闭 channel 前等待这些投递完成，避免向已关闭的
+// channel 发送数据。
+type entry struct {
+	topic topicFunc
+	wg    sync.WaitGroup
+}
+
+// Publisher 把事件广播给所有感兴趣的订阅者。
+type Publisher struct {
+	mu          sync.RWMutex
+	subscribers map[subscriber]*entry
+	timeout     time.Duration
+	closed      bool
+}
+
+// NewPublisher 创建一个 Publisher，publishTimeout 为向单个订阅者发送事件的超时时间；
+// 传入 0 表示使用 defaultPublishTimeout。
+func NewPublisher(publishTimeout time.Duration) *Publisher {
+	if publishTimeout <= 0 {
+		publishTimeout = defaultPublishTimeout
+	}
+	return &Publisher{
+		subscribers: make(map[subscriber]*entry),
+		timeout:     publishTimeout,
+	}
+}
+
+// Subscribe 注册一个接收全部事件的订阅者。
+func (p *Publisher) Subscribe() chan interface{} {
+	return p.SubscribeTopic(nil)
+}
+
+// SubscribeTopic 注册一个订阅者，topic 为 nil 时接收全部事件，
+// 否则只接收 topic(v) 返回 true 的事件。
+func (p *Publisher) SubscribeTopic(topic func(v interface{}) bool) chan interface{} {
+	ch := make(subscriber, subscriberBufferSize)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		close(ch)
+		return ch
+	}
+	p.subscribers[ch] = &entry{topic: topic}
+	return ch
+}
+
+// Evict 取消订阅 sub；它会等待所有已经派发给 sub 的 Publish 调用完成，
+// 再关闭 channel，从而避免向已关闭的 channel 发送数据。
+// sub 必须是 Subscribe/SubscribeTopic 返回的 channel，重复 Evict 是安全的。
+func (p *Publisher) Evict(sub chan interface{}) {
+	p.mu.Lock()
+	e, ok := p.subscribers[sub]
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.subscribers, sub)
+	p.mu.Unlock()
+
+	e.wg.Wait()
+	close(sub)
+}
+
+// Publish 把 v 发送给所有匹配的订阅者，每个订阅者在独立的 goroutine 中接收，
+// 超时未被消费的事件会被丢弃。Publish 只负责派发，不等待这些 goroutine
+// 完成就返回，因此一个慢订阅者只会拖慢它自己的投递，不会阻塞 Publish 的调用方。
+func (p *Publisher) Publish(v interface{}) {
+	p.mu.RLock()
+	entries := make(map[subscriber]*entry, len(p.subscribers))
+	for sub, e := range p.subscribers {
+		e.wg.Add(1)
+		entries[sub] = e
+	}
+	p.mu.RUnlock()
+
+	for sub, e := range entries {
+		go func(sub subscriber, e *entry) {
+			defer e.wg.Done()
+			if e.topic != nil && !e.topic(v) {
+				return
+			}
+			select {
+			case sub <- v:
+			case <-time.After(p.timeout):
+			}
+		}(sub, e)
+	}
+}
+
+// Close 关闭所有订阅者的 channel，多次调用是安全的。对每个订阅者，
+// Close 会先等待所有已经派发给它的 Publish 调用完成，再关闭其 channel。
+func (p *Publisher) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	subscribers := p.subscribers
+	p.subscribers = make(map[subscriber]*entry)
+	p.mu.Unlock()
+
+	for sub, e := range subscribers {
+		e.wg.Wait()
+		close(sub)
+	}
+}
@@ -0,0 +1,135 @@
+package pubsub
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPublishSubscribe(t *testing.T) {
+	p := NewPublisher(0)
+	defer p.Close()
+
+	sub := p.Subscribe()
+	p.Publish("hello")
+
+	select {
+	case got := <-sub:
+		if got != "hello" {
+			t.Errorf("got %v, want %q", got, "hello")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestSubscribeTopicFilters(t *testing.T) {
+	p := NewPublisher(0)
+	defer p.Close()
+
+	evens := p.SubscribeTopic(func(v interface{}) bool {
+		n, ok := v.(int)
+		return ok && n%2 == 0
+	})
+
+	for i := 0; i < 4; i++ {
+		p.Publish(i)
+	}
+
+	var got []int
+	for i := 0; i < 2; i++ {
+		select {
+		case v := <-evens:
+			got = append(got, v.(int))
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+
+	if len(got) != 2 || got[0] != 0 || got[1] != 2 {
+		t.Errorf("got %v, want [0 2]", got)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	p := NewPublisher(0)
+	defer p.Close()
+
+	sub := p.Subscribe()
+	p.Evict(sub)
+
+	if _, ok := <-sub; ok {
+		t.Error("expected sub to be closed after Evict")
+	}
+}
+
+func TestCloseIsIdempotentAndClosesSubscribers(t *testing.T) {
+	p := NewPublisher(0)
+	sub := p.Subscribe()
+
+	p.Close()
+	p.Close() // must not panic
+
+	if _, ok := <-sub; ok {
+		t.Error("expected sub to be closed after Close")
+	}
+}
+
+func TestPublishDoesNotBlockOnSlowSubscriber(t *testing.T) {
+	const publishTimeout = 200 * time.Millisecond
+	p := NewPublisher(publishTimeout)
+	defer p.Close()
+
+	sub := p.Subscribe()
+	// Never drain sub, so its buffer fills up and later sends must fall back
+	// to the per-send timeout instead of delivering immediately.
+	for i := 0; i < subscriberBufferSize; i++ {
+		p.Publish(i)
+	}
+
+	start := time.Now()
+	p.Publish("final")
+	if elapsed := time.Since(start); elapsed >= publishTimeout/2 {
+		t.Errorf("Publish() took %v, want it to return immediately without waiting for the per-send timeout (%v)", elapsed, publishTimeout)
+	}
+
+	t.Cleanup(func() { <-sub })
+}
+
+func TestConcurrentPublishAndSubscribe(t *testing.T) {
+	p := NewPublisher(0)
+	defer p.Close()
+
+	const subscribers = 10
+	const events = 50
+
+	var wg sync.WaitGroup
+	for i := 0; i < subscribers; i++ {
+		sub := p.Subscribe()
+		wg.Add(1)
+		go func(sub chan interface{}) {
+			defer wg.Done()
+			received := 0
+			for received < events {
+				select {
+				case <-sub:
+					received++
+				case <-time.After(2 * time.Second):
+					t.Errorf("subscriber only received %d/%d events", received, events)
+					return
+				}
+			}
+		}(sub)
+	}
+
+	var pubWg sync.WaitGroup
+	for i := 0; i < events; i++ {
+		pubWg.Add(1)
+		go func(i int) {
+			defer pubWg.Done()
+			p.Publish(i)
+		}(i)
+	}
+	pubWg.Wait()
+	wg.Wait()
+}
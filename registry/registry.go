@@ -0,0 +1,81 @@
+// Package registry 提供一个按姓名索引 Programmer 的内存注册表。
+package registry
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/studyzy/Gopher-Learning-Rust/programmer"
+)
+
+// ErrNotFound 表示注册表中不存在指定姓名的 Programmer。
+var ErrNotFound = errors.New("registry: programmer not found")
+
+// ErrDuplicate 表示注册表中已存在同名的 Programmer。
+var ErrDuplicate = errors.New("registry: programmer already exists")
+
+// Registry 是一个按姓名索引、并发安全的 Programmer 存储。
+type Registry struct {
+	mu          sync.RWMutex
+	programmers map[string]*programmer.Programmer
+}
+
+// New 创建一个空的 Registry。
+func New() *Registry {
+	return &Registry{
+		programmers: make(map[string]*programmer.Programmer),
+	}
+}
+
+// Add 把 p 加入注册表，若同名 Programmer 已存在则返回 ErrDuplicate。
+func (r *Registry) Add(p *programmer.Programmer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.programmers[p.Name()]; ok {
+		return ErrDuplicate
+	}
+	r.programmers[p.Name()] = p
+	return nil
+}
+
+// Get 按姓名查找 Programmer，找不到时返回 ErrNotFound。
+func (r *Registry) Get(name string) (*programmer.Programmer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.programmers[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return p, nil
+}
+
+// FindByLanguage 返回所有掌握指定语言的 Programmer，不保证顺序。
+func (r *Registry) FindByLanguage(lang string) []*programmer.Programmer {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var found []*programmer.Programmer
+	for _, p := range r.programmers {
+		for _, l := range p.Languages() {
+			if l == lang {
+				found = append(found, p)
+				break
+			}
+		}
+	}
+	return found
+}
+
+// Remove 按姓名删除 Programmer，找不到时返回 ErrNotFound。
+func (r *Registry) Remove(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.programmers[name]; !ok {
+		return ErrNotFound
+	}
+	delete(r.programmers, name)
+	return nil
+}
@@ -0,0 +1,91 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/studyzy/Gopher-Learning-Rust/programmer"
+)
+
+func newTestProgrammer(t *testing.T, name, language string) *programmer.Programmer {
+	t.Helper()
+	p, err := programmer.NewProgrammer(name, language)
+	if err != nil {
+		t.Fatalf("programmer.NewProgrammer(%q, %q) returned unexpected error: %v", name, language, err)
+	}
+	return p
+}
+
+func TestRegistryAddAndGet(t *testing.T) {
+	r := New()
+	alice := newTestProgrammer(t, "Alice", "Go")
+
+	if err := r.Add(alice); err != nil {
+		t.Fatalf("Add() returned unexpected error: %v", err)
+	}
+
+	got, err := r.Get("Alice")
+	if err != nil {
+		t.Fatalf("Get() returned unexpected error: %v", err)
+	}
+	if got != alice {
+		t.Errorf("Get() = %v, want %v", got, alice)
+	}
+}
+
+func TestRegistryAddDuplicate(t *testing.T) {
+	r := New()
+	alice := newTestProgrammer(t, "Alice", "Go")
+
+	if err := r.Add(alice); err != nil {
+		t.Fatalf("Add() returned unexpected error: %v", err)
+	}
+	if err := r.Add(alice); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicate)
+	}
+}
+
+func TestRegistryGetNotFound(t *testing.T) {
+	r := New()
+	if _, err := r.Get("Alice"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestRegistryFindByLanguage(t *testing.T) {
+	r := New()
+	alice := newTestProgrammer(t, "Alice", "Go")
+	bob := newTestProgrammer(t, "Bob", "Rust")
+	for _, p := range []*programmer.Programmer{alice, bob} {
+		if err := r.Add(p); err != nil {
+			t.Fatalf("Add() returned unexpected error: %v", err)
+		}
+	}
+
+	got := r.FindByLanguage("Go")
+	if len(got) != 1 || got[0] != alice {
+		t.Errorf("FindByLanguage(%q) = %v, want [%v]", "Go", got, alice)
+	}
+
+	if got := r.FindByLanguage("Python"); len(got) != 0 {
+		t.Errorf("FindByLanguage(%q) = %v, want empty", "Python", got)
+	}
+}
+
+func TestRegistryRemove(t *testing.T) {
+	r := New()
+	alice := newTestProgrammer(t, "Alice", "Go")
+	if err := r.Add(alice); err != nil {
+		t.Fatalf("Add() returned unexpected error: %v", err)
+	}
+
+	if err := r.Remove("Alice"); err != nil {
+		t.Fatalf("Remove() returned unexpected error: %v", err)
+	}
+	if _, err := r.Get("Alice"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after Remove() error = %v, want %v", err, ErrNotFound)
+	}
+	if err := r.Remove("Alice"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Remove() on missing entry error = %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/studyzy/Gopher-Learning-Rust/programmer"
+	"github.com/studyzy/Gopher-Learning-Rust/registry"
+)
+
+// programmerResponse 是程序员资源的 JSON 表示。
+type programmerResponse struct {
+	Name         string   `json:"name"`
+	Language     string   `json:"language"`
+	Languages    []string `json:"languages"`
+	Introduction string   `json:"introduction,omitempty"`
+}
+
+// createProgrammerRequest 是 POST /programmers 的请求体。
+type createProgrammerRequest struct {
+	Name      string   `json:"name"`
+	Language  string   `json:"language"`
+	Languages []string `json:"languages,omitempty"`
+}
+
+func toResponse(ctx context.Context, p *programmer.Programmer) programmerResponse {
+	intro, _ := p.IntroduceContext(ctx)
+	return programmerResponse{
+		Name:         p.Name(),
+		Language:     p.Language(),
+		Languages:    p.Languages(),
+		Introduction: intro,
+	}
+}
+
+// handleProgrammers 处理 GET /programmers(?language=) 与 POST /programmers。
+func (s *Server) handleProgrammers(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listProgrammers(w, r)
+	case http.MethodPost:
+		s.createProgrammer(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) listProgrammers(w http.ResponseWriter, r *http.Request) {
+	lang := r.URL.Query().Get("language")
+	if lang == "" {
+		writeError(w, http.StatusBadRequest, "query parameter 'language' is required")
+		return
+	}
+
+	found := s.reg.FindByLanguage(lang)
+	resp := make([]programmerResponse, 0, len(found))
+	for _, p := range found {
+		resp = append(resp, toResponse(r.Context(), p))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) createProgrammer(w http.ResponseWriter, r *http.Request) {
+	var req createProgrammerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	language := req.Language
+	var opts []programmer.Option
+	if len(req.Languages) > 0 {
+		if language == "" {
+			language = req.Languages[0]
+		}
+		opts = append(opts, programmer.WithLanguages(req.Languages...))
+	}
+
+	p, err := programmer.NewProgrammer(req.Name, language, opts...)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := s.reg.Add(p); err != nil {
+		if errors.Is(err, registry.ErrDuplicate) {
+			writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toResponse(r.Context(), p))
+}
+
+// handleProgrammerByName 处理 GET /programmers/{name}。
+func (s *Server) handleProgrammerByName(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/programmers/")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "programmer name is required")
+		return
+	}
+
+	p, err := s.reg.Get(name)
+	if err != nil {
+		if errors.Is(err, registry.ErrNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toResponse(r.Context(), p))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
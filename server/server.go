@@ -0,0 +1,50 @@
+// Package server 把 registry 包里的 ProgrammerRegistry 以 HTTP API 的形式暴露出来：
+//
+//	GET  /programmers?language=Go  列出掌握指定语言的程序员（language 为必填参数）
+//	GET  /programmers/{name}       查询单个程序员
+//	POST /programmers              创建一个程序员
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/studyzy/Gopher-Learning-Rust/registry"
+)
+
+// Server 是一个基于 registry.Registry 的 HTTP 服务。
+type Server struct {
+	reg        *registry.Registry
+	httpServer *http.Server
+}
+
+// New 创建一个监听 addr、基于 reg 提供服务的 Server。
+func New(reg *registry.Registry, addr string) *Server {
+	s := &Server{reg: reg}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/programmers", s.handleProgrammers)
+	mux.HandleFunc("/programmers/", s.handleProgrammerByName)
+
+	s.httpServer = &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+	return s
+}
+
+// Handler 返回底层的 http.Handler，便于测试时配合 httptest.NewServer 使用。
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
+}
+
+// ListenAndServe 启动 HTTP 服务，阻塞直到服务停止。
+func (s *Server) ListenAndServe() error {
+	return s.httpServer.ListenAndServe()
+}
+
+// Shutdown 优雅地关闭服务：等待已接收的请求处理完毕后再返回，
+// 或者在 ctx 超时/取消时提前返回。
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
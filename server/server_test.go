@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/studyzy/Gopher-Learning-Rust/programmer"
+	"github.com/studyzy/Gopher-Learning-Rust/registry"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *registry.Registry) {
+	t.Helper()
+	reg := registry.New()
+	s := New(reg, "")
+	ts := httptest.NewServer(s.Handler())
+	t.Cleanup(ts.Close)
+	return ts, reg
+}
+
+func TestCreateAndGetProgrammer(t *testing.T) {
+	ts, _ := newTestServer(t)
+
+	body, _ := json.Marshal(createProgrammerRequest{Name: "Alice", Language: "Go"})
+	resp, err := http.Post(ts.URL+"/programmers", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST /programmers returned error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /programmers status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	var created programmerResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if created.Name != "Alice" || created.Language != "Go" {
+		t.Errorf("got %+v, want Name=Alice Language=Go", created)
+	}
+
+	getResp, err := http.Get(ts.URL + "/programmers/Alice")
+	if err != nil {
+		t.Fatalf("GET /programmers/Alice returned error: %v", err)
+	}
+	defer getResp.Body.Close()
+	if getResp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /programmers/Alice status = %d, want %d", getResp.StatusCode, http.StatusOK)
+	}
+
+	var got programmerResponse
+	if err := json.NewDecoder(getResp.Body).Decode(&got); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if got.Name != "Alice" || got.Introduction == "" {
+		t.Errorf("got %+v, want Name=Alice with a non-empty Introduction", got)
+	}
+}
+
+func TestCreateProgrammerWithOnlyLanguages(t *testing.T) {
+	ts, _ := newTestServer(t)
+
+	body, _ := json.Marshal(createProgrammerRequest{Name: "Dave", Languages: []string{"Go", "Rust"}})
+	resp, err := http.Post(ts.URL+"/programmers", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST /programmers returned error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /programmers status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	var created programmerResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if created.Language != "Go" || len(created.Languages) != 2 {
+		t.Errorf("got %+v, want Language=Go with 2 Languages", created)
+	}
+}
+
+func TestCreateDuplicateProgrammer(t *testing.T) {
+	ts, reg := newTestServer(t)
+
+	p, err := programmer.NewProgrammer("Bob", "Go")
+	if err != nil {
+		t.Fatalf("programmer.NewProgrammer() returned unexpected error: %v", err)
+	}
+	if err := reg.Add(p); err != nil {
+		t.Fatalf("reg.Add() returned unexpected error: %v", err)
+	}
+
+	body, _ := json.Marshal(createProgrammerRequest{Name: "Bob", Language: "Go"})
+	resp, err := http.Post(ts.URL+"/programmers", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST /programmers returned error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("POST /programmers status = %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+}
+
+func TestGetProgrammerNotFound(t *testing.T) {
+	ts, _ := newTestServer(t)
+
+	resp, err := http.Get(ts.URL + "/programmers/Nobody")
+	if err != nil {
+		t.Fatalf("GET /programmers/Nobody returned error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("GET /programmers/Nobody status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	var errResp errorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("decoding error response: %v", err)
+	}
+	if errResp.Error == "" {
+		t.Error("expected a non-empty error message")
+	}
+}
+
+func TestListProgrammersByLanguage(t *testing.T) {
+	ts, reg := newTestServer(t)
+
+	for _, tc := range []struct{ name, language string }{
+		{"Alice", "Go"},
+		{"Carol", "Rust"},
+	} {
+		p, err := programmer.NewProgrammer(tc.name, tc.language)
+		if err != nil {
+			t.Fatalf("programmer.NewProgrammer() returned unexpected error: %v", err)
+		}
+		if err := reg.Add(p); err != nil {
+			t.Fatalf("reg.Add() returned unexpected error: %v", err)
+		}
+	}
+
+	resp, err := http.Get(ts.URL + "/programmers?language=Go")
+	if err != nil {
+		t.Fatalf("GET /programmers?language=Go returned error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /programmers?language=Go status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var got []programmerResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "Alice" {
+		t.Errorf("got %+v, want a single entry for Alice", got)
+	}
+}
+
+func TestShutdownGraceful(t *testing.T) {
+	reg := registry.New()
+	s := New(reg, "127.0.0.1:0")
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ListenAndServe()
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown() returned unexpected error: %v", err)
+	}
+
+	if err := <-errCh; err != http.ErrServerClosed {
+		t.Errorf("ListenAndServe() error = %v, want %v", err, http.ErrServerClosed)
+	}
+}